@@ -2,21 +2,30 @@ package cloudfront
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
-	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	fwdiag "github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
@@ -29,8 +38,9 @@ import (
 )
 
 const (
-	deleteVPCOriginTimeout = 15 * time.Minute
-	updateVPCOriginTimeout = 15 * time.Minute
+	deleteVPCOriginTimeout     = 15 * time.Minute
+	updateVPCOriginTimeout     = 15 * time.Minute
+	invalidateVPCOriginTimeout = 15 * time.Minute
 )
 
 // @FrameworkResource("aws_cloudfront_vpc_origin", name="VPC Origin")
@@ -55,6 +65,7 @@ func (r *cloudfrontVPCOriginResource) Metadata(_ context.Context, request resour
 
 func (r *cloudfrontVPCOriginResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			names.AttrARN: framework.ARNAttributeComputedOnly(),
 			names.AttrCreatedTime: schema.StringAttribute{
@@ -65,6 +76,16 @@ func (r *cloudfrontVPCOriginResource) Schema(ctx context.Context, request resour
 			"etag": schema.StringAttribute{
 				Computed: true,
 			},
+			"invalidation_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the CloudFront invalidations created by the most recent apply, when invalidate_on_update is enabled.",
+			},
+			"force_overwrite": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Apply this resource's plan even if the VPC Origin was changed out-of-band since the last refresh. Without this, out-of-band changes detected during an ETag-drift retry cause an error instead of being silently overwritten.",
+			},
 			names.AttrLastModifiedTime: schema.StringAttribute{
 				CustomType: timetypes.RFC3339Type{},
 				Computed:   true,
@@ -105,16 +126,113 @@ func (r *cloudfrontVPCOriginResource) Schema(ctx context.Context, request resour
 						Required:   true,
 						CustomType: fwtypes.StringEnumType[awstypes.OriginProtocolPolicy](),
 					},
+					names.AttrOriginSSLProtocols: schema.SetAttribute{
+						CustomType:  fwtypes.SetOfStringType,
+						Required:    true,
+						ElementType: types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+							setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.SslProtocol]()),
+						},
+					},
+				},
+			},
+			"invalidate_on_update": schema.SingleNestedBlock{
+				CustomType: fwtypes.NewObjectTypeOf[invalidateOnUpdateModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					names.AttrEnabled: schema.BoolAttribute{
+						Optional: true,
+					},
+					"distribution_ids": schema.SetAttribute{
+						CustomType:  fwtypes.SetOfStringType,
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"paths": schema.SetAttribute{
+						CustomType:  fwtypes.SetOfStringType,
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			names.AttrTimeouts: vpcOriginTimeoutsBlock(ctx),
+		},
+	}
+}
+
+// vpcOriginTimeoutsBlock extends the standard create/update/delete timeouts
+// block with an etag_retry key, so retryVPCOriginOnETagDrift's retry budget
+// is configured the same way Create/Update/Delete's timeouts already are,
+// instead of through a separate top-level attribute.
+func vpcOriginTimeoutsBlock(ctx context.Context) schema.Block {
+	block := timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Update: true,
+		Delete: true,
+	})
+
+	nested, ok := block.(schema.SingleNestedBlock)
+	if !ok {
+		return block
+	}
+
+	nested.Attributes["etag_retry"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "How long to keep refreshing the ETag and retrying Update/Delete when CloudFront reports it as stale. Defaults to 5m.",
+	}
+
+	return nested
+}
+
+// UpgradeState migrates state written before origin_ssl_protocols collapsed
+// from a `{ items, quantity }` nested block into a flat string set, so
+// existing state refreshes cleanly instead of forcing recreation.
+func (r *cloudfrontVPCOriginResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"etag": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrLastModifiedTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrVPCOriginEndpointConfig: schema.SingleNestedBlock{
+				CustomType: fwtypes.NewObjectTypeOf[vpcOriginEndpointConfigModelV0](ctx),
+				Attributes: map[string]schema.Attribute{
+					"origin_arn": schema.StringAttribute{
+						Required:   true,
+						CustomType: fwtypes.ARNType,
+					},
+					"http_port": schema.Int32Attribute{
+						Required: true,
+					},
+					"https_port": schema.Int32Attribute{
+						Required: true,
+					},
+					names.AttrName: schema.StringAttribute{
+						Required: true,
+					},
+					names.AttrOriginProtocolPolicy: schema.StringAttribute{
+						Required:   true,
+						CustomType: fwtypes.StringEnumType[awstypes.OriginProtocolPolicy](),
+					},
 				},
 				Blocks: map[string]schema.Block{
 					names.AttrOriginSSLProtocols: schema.ListNestedBlock{
-						CustomType: fwtypes.NewListNestedObjectTypeOf[originSSLProtocolsModel](ctx),
-						Validators: []validator.List{
-							listvalidator.IsRequired(),
-							listvalidator.SizeAtLeast(1),
-							listvalidator.SizeAtMost(1),
-						},
-						// TODO: User should be able to just specify an array, not object internals.
+						CustomType: fwtypes.NewListNestedObjectTypeOf[originSSLProtocolsModelV0](ctx),
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"items": schema.SetAttribute{
@@ -137,6 +255,61 @@ func (r *cloudfrontVPCOriginResource) Schema(ctx context.Context, request resour
 			}),
 		},
 	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schemaV0,
+			StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				var old vpcOriginModelV0
+				response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				oldConfigModel, d := old.VpcOriginEndpointConfig.ToPtr(ctx)
+				response.Diagnostics.Append(d...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				protocolBlocks, d := oldConfigModel.OriginSslProtocols.ToSlice(ctx)
+				response.Diagnostics.Append(d...)
+				if response.Diagnostics.HasError() {
+					return
+				}
+
+				sslProtocols := fwtypes.NewSetValueOfNull[types.String](ctx)
+				if len(protocolBlocks) > 0 {
+					sslProtocols = protocolBlocks[0].Items
+				}
+
+				new := vpcOriginModel{
+					ARN:                old.ARN,
+					CreatedTime:        old.CreatedTime,
+					Id:                 old.Id,
+					ETag:               old.ETag,
+					ForceOverwrite:     types.BoolNull(),
+					InvalidateOnUpdate: fwtypes.NewObjectValueOfNull[invalidateOnUpdateModel](ctx),
+					InvalidationIDs:    fwtypes.NewSetValueOfNull[types.String](ctx),
+					LastModifiedTime:   old.LastModifiedTime,
+					Status:             old.Status,
+					Tags:               old.Tags,
+					Timeouts:           old.Timeouts,
+				}
+
+				new.VpcOriginEndpointConfig = fwtypes.NewObjectValueOfMust(ctx, &vpcOriginEndpointConfigModel{
+					Arn:                  oldConfigModel.Arn,
+					HTTPPort:             oldConfigModel.HTTPPort,
+					HTTPSPort:            oldConfigModel.HTTPSPort,
+					Name:                 oldConfigModel.Name,
+					OriginProtocolPolicy: oldConfigModel.OriginProtocolPolicy,
+					OriginSslProtocols:   sslProtocols,
+				})
+
+				response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+			},
+		},
+	}
 }
 
 func (r *cloudfrontVPCOriginResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -149,6 +322,22 @@ func (r *cloudfrontVPCOriginResource) Create(ctx context.Context, request resour
 	conn := r.Meta().CloudFrontClient(ctx)
 	var input cloudfront.CreateVpcOriginInput
 	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	configModel, d := data.VpcOriginEndpointConfig.ToPtr(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	sslProtocols, d := expandOriginSSLProtocols(ctx, configModel.OriginSslProtocols)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.VpcOriginEndpointConfig.OriginSslProtocols = sslProtocols
 
 	if tags := getTagsIn(ctx); len(tags) > 0 {
 		input.Tags.Items = tags
@@ -171,6 +360,7 @@ func (r *cloudfrontVPCOriginResource) Create(ctx context.Context, request resour
 	data.LastModifiedTime = fwflex.TimeToFramework(ctx, output.VpcOrigin.LastModifiedTime)
 	data.Status = fwflex.StringToFramework(ctx, output.VpcOrigin.Status)
 	data.ETag = fwflex.StringToFramework(ctx, output.ETag)
+	data.InvalidationIDs = fwtypes.NewSetValueOfNull[types.String](ctx)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -213,6 +403,11 @@ func (r *cloudfrontVPCOriginResource) Read(ctx context.Context, request resource
 	data.Status = fwflex.StringToFramework(ctx, output.VpcOrigin.Status)
 	data.ETag = fwflex.StringToFramework(ctx, output.ETag)
 
+	response.Diagnostics.Append(setOriginSSLProtocols(ctx, &data, output.VpcOrigin)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -241,8 +436,45 @@ func (r *cloudfrontVPCOriginResource) Update(ctx context.Context, request resour
 		return
 	}
 
+	newConfigModel, d := new.VpcOriginEndpointConfig.ToPtr(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	sslProtocols, d := expandOriginSSLProtocols(ctx, newConfigModel.OriginSslProtocols)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.VpcOriginEndpointConfig.OriginSslProtocols = sslProtocols
+
 	output, err := conn.UpdateVpcOrigin(ctx, input)
 
+	if isVPCOriginETagStale(err) {
+		etagRetryTimeout, diags := vpcOriginETagRetryTimeout(ctx, request.Plan)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		diags = retryVPCOriginOnETagDrift(ctx, conn, old.Id.ValueString(), newConfigModel, new.ForceOverwrite.ValueBool(), etagRetryTimeout,
+			func(etag string) { input.IfMatch = aws.String(etag) },
+			func() error {
+				var opErr error
+				output, opErr = conn.UpdateVpcOrigin(ctx, input)
+				return opErr
+			},
+		)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating CloudFront VPC Origin (%s)", old.Id.ValueString()), err.Error())
+		return
+	}
+
 	updateTimeout := r.UpdateTimeout(ctx, old.Timeouts)
 	if _, err = waitVPCOriginDeployed(ctx, conn, old.Id.ValueString(), updateTimeout); err != nil {
 		response.Diagnostics.AddError(
@@ -262,8 +494,254 @@ func (r *cloudfrontVPCOriginResource) Update(ctx context.Context, request resour
 	new.Status = fwflex.StringToFramework(ctx, output.VpcOrigin.Status)
 	new.ETag = fwflex.StringToFramework(ctx, output.ETag)
 
+	oldConfigModel, d := old.VpcOriginEndpointConfig.ToPtr(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	new.InvalidationIDs = fwtypes.NewSetValueOfNull[types.String](ctx)
+	if vpcOriginEndpointConfigRequiresInvalidation(ctx, oldConfigModel, newConfigModel) {
+		invalidationIDs, diags := invalidateVPCOriginConsumers(ctx, conn, new)
+		response.Diagnostics.Append(diags...)
+		new.InvalidationIDs = fwtypes.NewSetValueOfMust[types.String](ctx, stringsToValues(invalidationIDs))
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// vpcOriginEndpointConfigRequiresInvalidation reports whether a change
+// between old and new touches the fields that affect how CloudFront talks
+// to the origin (protocol policy, ports, SSL protocols) and so could leave
+// edge caches serving with a stale origin connection until invalidated.
+func vpcOriginEndpointConfigRequiresInvalidation(ctx context.Context, old, new *vpcOriginEndpointConfigModel) bool {
+	if old.OriginProtocolPolicy.ValueString() != new.OriginProtocolPolicy.ValueString() {
+		return true
+	}
+	if old.HTTPPort.ValueInt32() != new.HTTPPort.ValueInt32() {
+		return true
+	}
+	if old.HTTPSPort.ValueInt32() != new.HTTPSPort.ValueInt32() {
+		return true
+	}
+
+	var oldProtocols, newProtocols []string
+	// If either side can't be read, treat it as a change so an invalidation
+	// isn't silently skipped.
+	if old.OriginSslProtocols.ElementsAs(ctx, &oldProtocols, false).HasError() {
+		return true
+	}
+	if new.OriginSslProtocols.ElementsAs(ctx, &newProtocols, false).HasError() {
+		return true
+	}
+	slices.Sort(oldProtocols)
+	slices.Sort(newProtocols)
+
+	return !slices.Equal(oldProtocols, newProtocols)
+}
+
+// invalidateVPCOriginConsumers issues a CreateInvalidation against every
+// CloudFront distribution that references this VPC Origin (or the
+// caller-supplied subset), so that edge locations pick up the new endpoint
+// config without waiting for their natural TTL. It returns the resulting
+// invalidation IDs so they can be surfaced as a computed attribute.
+func invalidateVPCOriginConsumers(ctx context.Context, conn *cloudfront.Client, data vpcOriginModel) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var invalidationIDs []string
+
+	if data.InvalidateOnUpdate.IsNull() {
+		return invalidationIDs, diags
+	}
+
+	invalidateModel, d := data.InvalidateOnUpdate.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || invalidateModel.Enabled.IsNull() || !invalidateModel.Enabled.ValueBool() {
+		return invalidationIDs, diags
+	}
+
+	distributionIDs, d := expandInvalidationDistributionIDs(ctx, conn, data.Id.ValueString(), invalidateModel.DistributionIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return invalidationIDs, diags
+	}
+
+	paths := []string{"/*"}
+	if !invalidateModel.Paths.IsNull() {
+		var configuredPaths []string
+		diags.Append(invalidateModel.Paths.ElementsAs(ctx, &configuredPaths, false)...)
+		if diags.HasError() {
+			return invalidationIDs, diags
+		}
+		paths = configuredPaths
+	}
+
+	for _, distributionID := range distributionIDs {
+		callerReference := vpcOriginInvalidationCallerReference(data.Id.ValueString(), data.ETag.ValueString(), distributionID, paths)
+
+		invalidationID, err := createAndWaitForInvalidation(ctx, conn, distributionID, paths, callerReference, invalidateVPCOriginTimeout)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("invalidating CloudFront distribution (%s) for VPC Origin (%s)", distributionID, data.Id.ValueString()), err.Error())
+			continue
+		}
+
+		invalidationIDs = append(invalidationIDs, invalidationID)
+	}
+
+	return invalidationIDs, diags
+}
+
+// vpcOriginInvalidationCallerReference derives CreateInvalidation's
+// idempotency key from the plan (the VPC Origin, its new ETag, the target
+// distribution and the paths being invalidated) instead of a random or
+// time-based value, so re-applying the same plan reuses the same
+// invalidation rather than creating a new one every time.
+func vpcOriginInvalidationCallerReference(vpcOriginID, etag, distributionID string, paths []string) string {
+	sortedPaths := slices.Clone(paths)
+	slices.Sort(sortedPaths)
+
+	sum := sha256.Sum256([]byte(strings.Join([]string{vpcOriginID, etag, distributionID, strings.Join(sortedPaths, ",")}, "|")))
+
+	return "tf-" + hex.EncodeToString(sum[:])[:32]
+}
+
+func expandInvalidationDistributionIDs(ctx context.Context, conn *cloudfront.Client, vpcOriginID string, tfSet fwtypes.SetValueOf[types.String]) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !tfSet.IsNull() {
+		var ids []string
+		diags.Append(tfSet.ElementsAs(ctx, &ids, false)...)
+		return ids, diags
+	}
+
+	ids, err := findDistributionIDsByVPCOriginID(ctx, conn, vpcOriginID)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("listing CloudFront distributions for VPC Origin (%s)", vpcOriginID), err.Error())
+	}
+
+	return ids, diags
+}
+
+func findDistributionIDsByVPCOriginID(ctx context.Context, conn *cloudfront.Client, vpcOriginID string) ([]string, error) {
+	input := &cloudfront.ListDistributionsByVpcOriginIdInput{
+		VpcOriginId: aws.String(vpcOriginID),
+	}
+	var ids []string
+
+	for {
+		output, err := conn.ListDistributionsByVpcOriginId(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.DistributionIdList == nil {
+			break
+		}
+
+		ids = append(ids, output.DistributionIdList.Items...)
+
+		if output.DistributionIdList.NextMarker == nil {
+			break
+		}
+		input.Marker = output.DistributionIdList.NextMarker
+	}
+
+	return ids, nil
+}
+
+func createAndWaitForInvalidation(ctx context.Context, conn *cloudfront.Client, distributionID string, paths []string, callerReference string, timeout time.Duration) (string, error) {
+	input := &cloudfront.CreateInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		InvalidationBatch: &awstypes.InvalidationBatch{
+			CallerReference: aws.String(callerReference),
+			Paths: &awstypes.Paths{
+				Items:    paths,
+				Quantity: aws.Int32(int32(len(paths))),
+			},
+		},
+	}
+
+	output, err := conn.CreateInvalidation(ctx, input)
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.Invalidation == nil {
+		return "", fmt.Errorf("creating CloudFront invalidation (%s): empty response", distributionID)
+	}
+
+	id := aws.ToString(output.Invalidation.Id)
+
+	return id, waitInvalidationCompleted(ctx, conn, distributionID, id, timeout)
+}
+
+func invalidationStatus(ctx context.Context, conn *cloudfront.Client, distributionID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &cloudfront.GetInvalidationInput{
+			DistributionId: aws.String(distributionID),
+			Id:             aws.String(id),
+		}
+
+		output, err := conn.GetInvalidation(ctx, input)
+
+		if errs.IsA[*awstypes.NoSuchInvalidation](err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
 
+		if output == nil || output.Invalidation == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.ToString(output.Invalidation.Status), nil
+	}
+}
+
+func waitInvalidationCompleted(ctx context.Context, conn *cloudfront.Client, distributionID, id string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"InProgress"},
+		Target:  []string{"Completed"},
+		Refresh: invalidationStatus(ctx, conn, distributionID, id),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func (r *cloudfrontVPCOriginResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	output, err := findVPCOriginByID(ctx, conn, request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("importing CloudFront VPC Origin (%s)", request.ID), err.Error())
+		return
+	}
+
+	var data vpcOriginModel
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.VpcOrigin, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.VpcOrigin.Arn)
+	data.CreatedTime = fwflex.TimeToFramework(ctx, output.VpcOrigin.CreatedTime)
+	data.Id = fwflex.StringToFramework(ctx, output.VpcOrigin.Id)
+	data.LastModifiedTime = fwflex.TimeToFramework(ctx, output.VpcOrigin.LastModifiedTime)
+	data.Status = fwflex.StringToFramework(ctx, output.VpcOrigin.Status)
+	data.ETag = fwflex.StringToFramework(ctx, output.ETag)
+	data.InvalidationIDs = fwtypes.NewSetValueOfNull[types.String](ctx)
+
+	response.Diagnostics.Append(setOriginSSLProtocols(ctx, &data, output.VpcOrigin)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 func (r *cloudfrontVPCOriginResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -282,6 +760,38 @@ func (r *cloudfrontVPCOriginResource) Delete(ctx context.Context, request resour
 
 	_, err := conn.DeleteVpcOrigin(ctx, input)
 
+	if isVPCOriginETagStale(err) {
+		configModel, d := data.VpcOriginEndpointConfig.ToPtr(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		etagRetryTimeout, diags := vpcOriginETagRetryTimeout(ctx, request.State)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		diags = retryVPCOriginOnETagDrift(ctx, conn, data.Id.ValueString(), configModel, data.ForceOverwrite.ValueBool(), etagRetryTimeout,
+			func(etag string) { input.IfMatch = aws.String(etag) },
+			func() error {
+				_, opErr := conn.DeleteVpcOrigin(ctx, input)
+				return opErr
+			},
+		)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		err = nil
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting CloudFront VPC Origin (%s)", data.Id.ValueString()), err.Error())
+		return
+	}
+
 	deleteTimeout := r.DeleteTimeout(ctx, data.Timeouts)
 	if _, err = waitVPCOriginDeleted(ctx, conn, data.Id.ValueString(), deleteTimeout); err != nil {
 		response.Diagnostics.AddError(
@@ -350,6 +860,175 @@ func waitVPCOriginDeleted(ctx context.Context, conn *cloudfront.Client, id strin
 	return nil, err
 }
 
+// isVPCOriginETagStale reports whether err is CloudFront telling us the
+// ETag we sent as IfMatch no longer matches the VPC Origin's current state,
+// i.e. something else updated it since our last refresh.
+func isVPCOriginETagStale(err error) bool {
+	return errs.IsA[*awstypes.PreconditionFailed](err) || errs.IsA[*awstypes.InvalidIfMatchVersion](err)
+}
+
+// defaultETagRetryTimeout bounds how long retryVPCOriginOnETagDrift will
+// keep refreshing the ETag and retrying when timeouts.etag_retry isn't set.
+const defaultETagRetryTimeout = 5 * time.Minute
+
+// etagDriftRetryAttributePath is timeouts.etag_retry, the optional duration
+// string nested alongside create/update/delete in the resource's existing
+// timeouts block (see vpcOriginTimeoutsBlock).
+var etagDriftRetryAttributePath = path.Root(names.AttrTimeouts).AtName("etag_retry")
+
+// vpcOriginAttributeGetter is satisfied by both tfsdk.Plan and tfsdk.State,
+// letting vpcOriginETagRetryTimeout read timeouts.etag_retry from whichever
+// one the caller has on hand (Update has a plan, Delete only has state).
+type vpcOriginAttributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target any) diag.Diagnostics
+}
+
+// vpcOriginETagRetryTimeout reads and parses timeouts.etag_retry, falling
+// back to defaultETagRetryTimeout when it's unset. An invalid duration
+// string is surfaced as an attribute-level diagnostic rather than silently
+// falling back, so a typo doesn't quietly shorten/lengthen the retry budget.
+func vpcOriginETagRetryTimeout(ctx context.Context, getter vpcOriginAttributeGetter) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var raw types.String
+	diags.Append(getter.GetAttribute(ctx, etagDriftRetryAttributePath, &raw)...)
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	if raw.IsNull() || raw.ValueString() == "" {
+		return defaultETagRetryTimeout, diags
+	}
+
+	d, err := time.ParseDuration(raw.ValueString())
+	if err != nil {
+		diags.AddAttributeError(etagDriftRetryAttributePath, "Invalid Timeout",
+			fmt.Sprintf("timeouts.etag_retry value %q is not a valid duration: %s", raw.ValueString(), err))
+		return 0, diags
+	}
+
+	return d, diags
+}
+
+// diffVPCOriginEndpointConfig returns the names of the endpoint config
+// attributes where AWS's current state differs from what's planned. Only
+// origin_protocol_policy, http_port, https_port and origin_ssl_protocols are
+// compared; name and origin_arn are not.
+func diffVPCOriginEndpointConfig(ctx context.Context, remote *awstypes.VpcOriginEndpointConfig, planned *vpcOriginEndpointConfigModel) []string {
+	var drifted []string
+
+	if remote == nil || planned == nil {
+		return drifted
+	}
+
+	if string(remote.OriginProtocolPolicy) != planned.OriginProtocolPolicy.ValueString() {
+		drifted = append(drifted, names.AttrOriginProtocolPolicy)
+	}
+	if aws.ToInt32(remote.HTTPPort) != planned.HTTPPort.ValueInt32() {
+		drifted = append(drifted, "http_port")
+	}
+	if aws.ToInt32(remote.HTTPSPort) != planned.HTTPSPort.ValueInt32() {
+		drifted = append(drifted, "https_port")
+	}
+
+	var plannedProtocols []string
+	planned.OriginSslProtocols.ElementsAs(ctx, &plannedProtocols, false)
+	slices.Sort(plannedProtocols)
+
+	var remoteProtocols []string
+	if remote.OriginSslProtocols != nil {
+		remoteProtocols = slices.Clone(remote.OriginSslProtocols.Items)
+	}
+	slices.Sort(remoteProtocols)
+
+	if !slices.Equal(plannedProtocols, remoteProtocols) {
+		drifted = append(drifted, names.AttrOriginSSLProtocols)
+	}
+
+	return drifted
+}
+
+// etagDriftRetryMinInterval and etagDriftRetryMaxInterval bound the backoff
+// retryVPCOriginOnETagDrift waits between attempts, so a real ETag race
+// polls CloudFront at a reasonable cadence instead of busy-spinning as fast
+// as the network round-trip allows.
+const (
+	etagDriftRetryMinInterval = 5 * time.Second
+	etagDriftRetryMaxInterval = 30 * time.Second
+)
+
+// retryVPCOriginOnETagDrift handles a PreconditionFailed/InvalidIfMatchVersion
+// error from Update or Delete by refreshing the VPC Origin's current ETag and
+// retrying do, bounded by timeout. Before each retry it diffs the refreshed
+// remote config against planned: if they differ and force_overwrite wasn't
+// set, it returns a diagnostic naming the drifted attributes instead of
+// clobbering the out-of-band change. setIfMatch is called with the fresh
+// ETag before each attempt so the caller's input reflects it. Attempts are
+// spaced out by an increasing backoff, same intent as the StateChangeConf
+// polling used elsewhere in this file, since do() mutates rather than just
+// polls a status and so doesn't fit StateChangeConf's Refresh shape directly.
+func retryVPCOriginOnETagDrift(ctx context.Context, conn *cloudfront.Client, id string, planned *vpcOriginEndpointConfigModel, forceOverwrite bool, timeout time.Duration, setIfMatch func(etag string), do func() error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	deadline := time.Now().Add(timeout)
+
+	for attempt := 1; ; attempt++ {
+		current, err := findVPCOriginByID(ctx, conn, id)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("refreshing CloudFront VPC Origin (%s) after ETag drift", id), err.Error())
+			return diags
+		}
+
+		if !forceOverwrite {
+			if drifted := diffVPCOriginEndpointConfig(ctx, current.VpcOrigin.VpcOriginEndpointConfig, planned); len(drifted) > 0 {
+				diags.AddError(
+					fmt.Sprintf("CloudFront VPC Origin (%s) changed out-of-band", id),
+					fmt.Sprintf("The following vpc_origin_endpoint_config attributes no longer match the plan: %s. "+
+						"Refresh and reconcile the configuration, or set force_overwrite = true to apply the plan anyway.",
+						strings.Join(drifted, ", ")),
+				)
+				return diags
+			}
+		}
+
+		tflog.Debug(ctx, "retrying CloudFront VPC Origin operation after ETag drift", map[string]any{
+			"id":      id,
+			"attempt": attempt,
+			"etag":    aws.ToString(current.ETag),
+		})
+
+		setIfMatch(aws.ToString(current.ETag))
+		err = do()
+
+		if !isVPCOriginETagStale(err) {
+			if err != nil {
+				diags.AddError(fmt.Sprintf("retrying CloudFront VPC Origin operation (%s)", id), err.Error())
+			}
+			return diags
+		}
+
+		if time.Now().After(deadline) {
+			diags.AddError(
+				fmt.Sprintf("retrying CloudFront VPC Origin operation (%s)", id),
+				fmt.Sprintf("gave up after %d attempts: ETag kept drifting within the %s etag_retry timeout", attempt, timeout),
+			)
+			return diags
+		}
+
+		interval := etagDriftRetryMinInterval * time.Duration(attempt)
+		if interval > etagDriftRetryMaxInterval {
+			interval = etagDriftRetryMaxInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError(fmt.Sprintf("retrying CloudFront VPC Origin operation (%s)", id), ctx.Err().Error())
+			return diags
+		case <-time.After(interval):
+		}
+	}
+}
+
 func findVPCOriginByID(ctx context.Context, conn *cloudfront.Client, id string) (*cloudfront.GetVpcOriginOutput, error) {
 	input := &cloudfront.GetVpcOriginInput{
 		Id: aws.String(id),
@@ -373,6 +1052,9 @@ type vpcOriginModel struct {
 	CreatedTime             timetypes.RFC3339                                   `tfsdk:"created_time"`
 	Id                      types.String                                        `tfsdk:"id"`
 	ETag                    types.String                                        `tfsdk:"etag"`
+	ForceOverwrite          types.Bool                                          `tfsdk:"force_overwrite"`
+	InvalidateOnUpdate      fwtypes.ObjectValueOf[invalidateOnUpdateModel]      `tfsdk:"invalidate_on_update"`
+	InvalidationIDs         fwtypes.SetValueOf[types.String]                    `tfsdk:"invalidation_ids"`
 	LastModifiedTime        timetypes.RFC3339                                   `tfsdk:"last_modified_time"`
 	Status                  types.String                                        `tfsdk:"status"`
 	VpcOriginEndpointConfig fwtypes.ObjectValueOf[vpcOriginEndpointConfigModel] `tfsdk:"vpc_origin_endpoint_config"`
@@ -380,16 +1062,98 @@ type vpcOriginModel struct {
 	Timeouts                timeouts.Value                                      `tfsdk:"timeouts"`
 }
 
+type invalidateOnUpdateModel struct {
+	Enabled         types.Bool                       `tfsdk:"enabled"`
+	DistributionIDs fwtypes.SetValueOf[types.String] `tfsdk:"distribution_ids"`
+	Paths           fwtypes.SetValueOf[types.String] `tfsdk:"paths"`
+}
+
 type vpcOriginEndpointConfigModel struct {
+	Arn                  types.String                                      `tfsdk:"origin_arn"`
+	HTTPPort             types.Int32                                       `tfsdk:"http_port"`
+	HTTPSPort            types.Int32                                       `tfsdk:"https_port"`
+	Name                 types.String                                      `tfsdk:"name"`
+	OriginProtocolPolicy fwtypes.StringEnum[awstypes.OriginProtocolPolicy] `tfsdk:"origin_protocol_policy"`
+	OriginSslProtocols   fwtypes.SetValueOf[types.String]                  `tfsdk:"origin_ssl_protocols"`
+}
+
+// vpcOriginModelV0, vpcOriginEndpointConfigModelV0 and
+// originSSLProtocolsModelV0 describe the pre-collapse schema (schema version
+// 0), where origin_ssl_protocols was a one-element `{ items, quantity }`
+// nested block instead of a flat string set. They exist solely so
+// UpgradeState can read state written by that version.
+type vpcOriginModelV0 struct {
+	ARN                     types.String                                          `tfsdk:"arn"`
+	CreatedTime             timetypes.RFC3339                                     `tfsdk:"created_time"`
+	Id                      types.String                                          `tfsdk:"id"`
+	ETag                    types.String                                          `tfsdk:"etag"`
+	LastModifiedTime        timetypes.RFC3339                                     `tfsdk:"last_modified_time"`
+	Status                  types.String                                          `tfsdk:"status"`
+	VpcOriginEndpointConfig fwtypes.ObjectValueOf[vpcOriginEndpointConfigModelV0] `tfsdk:"vpc_origin_endpoint_config"`
+	Tags                    tftags.Map                                            `tfsdk:"tags"`
+	Timeouts                timeouts.Value                                        `tfsdk:"timeouts"`
+}
+
+type vpcOriginEndpointConfigModelV0 struct {
 	Arn                  types.String                                             `tfsdk:"origin_arn"`
 	HTTPPort             types.Int32                                              `tfsdk:"http_port"`
 	HTTPSPort            types.Int32                                              `tfsdk:"https_port"`
 	Name                 types.String                                             `tfsdk:"name"`
 	OriginProtocolPolicy fwtypes.StringEnum[awstypes.OriginProtocolPolicy]        `tfsdk:"origin_protocol_policy"`
-	OriginSslProtocols   fwtypes.ListNestedObjectValueOf[originSSLProtocolsModel] `tfsdk:"origin_ssl_protocols"`
+	OriginSslProtocols   fwtypes.ListNestedObjectValueOf[originSSLProtocolsModelV0] `tfsdk:"origin_ssl_protocols"`
 }
 
-type originSSLProtocolsModel struct {
+type originSSLProtocolsModelV0 struct {
 	Items    fwtypes.SetValueOf[types.String] `tfsdk:"items"`
 	Quantity types.Int64                      `tfsdk:"quantity"`
+}
+
+// expandOriginSSLProtocols derives the API's Items/Quantity shape from the
+// flat set of SSL protocol strings Terraform practitioners configure.
+func expandOriginSSLProtocols(ctx context.Context, tfSet fwtypes.SetValueOf[types.String]) (*awstypes.OriginSslProtocols, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tfSet.IsNull() || tfSet.IsUnknown() {
+		return nil, diags
+	}
+
+	var items []string
+	diags.Append(tfSet.ElementsAs(ctx, &items, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &awstypes.OriginSslProtocols{
+		Items:    items,
+		Quantity: aws.Int32(int32(len(items))),
+	}, diags
+}
+
+func flattenOriginSSLProtocols(ctx context.Context, apiObject *awstypes.OriginSslProtocols) (fwtypes.SetValueOf[types.String], diag.Diagnostics) {
+	if apiObject == nil {
+		return fwtypes.NewSetValueOfNull[types.String](ctx), nil
+	}
+
+	return fwtypes.NewSetValueOfMust[types.String](ctx, stringsToValues(apiObject.Items)), nil
+}
+
+// setOriginSSLProtocols overwrites the flattened vpc_origin_endpoint_config's
+// origin_ssl_protocols with the API response, since fwflex.Flatten can't map
+// the API's Items/Quantity shape onto a flat set on its own.
+func setOriginSSLProtocols(ctx context.Context, data *vpcOriginModel, apiObject *awstypes.VpcOrigin) diag.Diagnostics {
+	configModel, diags := data.VpcOriginEndpointConfig.ToPtr(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	sslProtocols, d := flattenOriginSSLProtocols(ctx, apiObject.VpcOriginEndpointConfig.OriginSslProtocols)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	configModel.OriginSslProtocols = sslProtocols
+
+	data.VpcOriginEndpointConfig = fwtypes.NewObjectValueOfMust(ctx, configModel)
+
+	return diags
 }
\ No newline at end of file
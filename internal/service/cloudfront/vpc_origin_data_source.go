@@ -0,0 +1,207 @@
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudfront_vpc_origin", name="VPC Origin")
+func newCloudfrontVPCOriginDataSource(_ context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &cloudfrontVPCOriginDataSource{}, nil
+}
+
+type cloudfrontVPCOriginDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *cloudfrontVPCOriginDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_cloudfront_vpc_origin"
+}
+
+func (d *cloudfrontVPCOriginDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"etag": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrLastModifiedTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrVPCOriginEndpointConfig: schema.SingleNestedBlock{
+				CustomType: fwtypes.NewObjectTypeOf[vpcOriginEndpointConfigModel](ctx),
+				Attributes: map[string]schema.Attribute{
+					"origin_arn": schema.StringAttribute{
+						Computed:   true,
+						CustomType: fwtypes.ARNType,
+					},
+					"http_port": schema.Int32Attribute{
+						Computed: true,
+					},
+					"https_port": schema.Int32Attribute{
+						Computed: true,
+					},
+					names.AttrName: schema.StringAttribute{
+						Computed: true,
+					},
+					names.AttrOriginProtocolPolicy: schema.StringAttribute{
+						Computed:   true,
+						CustomType: fwtypes.StringEnumType[awstypes.OriginProtocolPolicy](),
+					},
+					names.AttrOriginSSLProtocols: schema.SetAttribute{
+						CustomType:  fwtypes.SetOfStringType,
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *cloudfrontVPCOriginDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data vpcOriginDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsNull() && data.Name.IsNull() {
+		response.Diagnostics.AddError("Missing Required Argument", "Either \"id\" or \"name\" must be specified.")
+		return
+	}
+
+	conn := d.Meta().CloudFrontClient(ctx)
+
+	id := data.Id.ValueString()
+	if data.Id.IsNull() {
+		summary, err := findVPCOriginByName(ctx, conn, data.Name.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront VPC Origin (%s)", data.Name.ValueString()), err.Error())
+			return
+		}
+
+		id = aws.ToString(summary.Id)
+	}
+
+	output, err := findVPCOriginByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront VPC Origin (%s)", id), err.Error())
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.VpcOrigin.Arn)
+	data.CreatedTime = fwflex.TimeToFramework(ctx, output.VpcOrigin.CreatedTime)
+	data.Id = fwflex.StringToFramework(ctx, output.VpcOrigin.Id)
+	data.LastModifiedTime = fwflex.TimeToFramework(ctx, output.VpcOrigin.LastModifiedTime)
+	data.Status = fwflex.StringToFramework(ctx, output.VpcOrigin.Status)
+	data.ETag = fwflex.StringToFramework(ctx, output.ETag)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.VpcOrigin, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	configModel, d := data.VpcOriginEndpointConfig.ToPtr(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	sslProtocols, d := flattenOriginSSLProtocols(ctx, output.VpcOrigin.VpcOriginEndpointConfig.OriginSslProtocols)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	configModel.OriginSslProtocols = sslProtocols
+
+	// Populate the top-level name (the lookup key for name-based lookups,
+	// otherwise unset after an id-based lookup) from the endpoint config's
+	// name, since fwflex.Flatten only sets it inside
+	// vpc_origin_endpoint_config.
+	data.Name = configModel.Name
+	data.VpcOriginEndpointConfig = fwtypes.NewObjectValueOfMust(ctx, configModel)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findVPCOriginByName(ctx context.Context, conn *cloudfront.Client, name string) (*awstypes.VpcOriginSummary, error) {
+	input := &cloudfront.ListVpcOriginsInput{}
+	var results []awstypes.VpcOriginSummary
+
+	for {
+		output, err := conn.ListVpcOrigins(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.VpcOriginList == nil {
+			break
+		}
+
+		for _, item := range output.VpcOriginList.Items {
+			if aws.ToString(item.Name) == name {
+				results = append(results, item)
+			}
+		}
+
+		if output.VpcOriginList.NextMarker == nil {
+			break
+		}
+		input.Marker = output.VpcOriginList.NextMarker
+	}
+
+	if len(results) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if len(results) > 1 {
+		return nil, tfresource.NewTooManyResultsError(len(results), input)
+	}
+
+	return &results[0], nil
+}
+
+type vpcOriginDataSourceModel struct {
+	ARN                     types.String                                        `tfsdk:"arn"`
+	CreatedTime             timetypes.RFC3339                                   `tfsdk:"created_time"`
+	Id                      types.String                                        `tfsdk:"id"`
+	ETag                    types.String                                        `tfsdk:"etag"`
+	LastModifiedTime        timetypes.RFC3339                                   `tfsdk:"last_modified_time"`
+	Name                    types.String                                        `tfsdk:"name"`
+	Status                  types.String                                        `tfsdk:"status"`
+	VpcOriginEndpointConfig fwtypes.ObjectValueOf[vpcOriginEndpointConfigModel] `tfsdk:"vpc_origin_endpoint_config"`
+}
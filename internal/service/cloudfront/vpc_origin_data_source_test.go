@@ -0,0 +1,154 @@
+package cloudfront
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontVPCOriginDataSource_id(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vpcorigin cloudfront.GetVpcOriginOutput
+	resourceName := "aws_cloudfront_vpc_origin.test"
+	dataSourceName := "data.aws_cloudfront_vpc_origin.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCOriginDataSourceConfig_id(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCOriginExists(ctx, resourceName, &vpcorigin),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, fmt.Sprintf("%s.%s", names.AttrVPCOriginEndpointConfig, names.AttrName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontVPCOriginDataSource_name(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vpcorigin cloudfront.GetVpcOriginOutput
+	resourceName := "aws_cloudfront_vpc_origin.test"
+	dataSourceName := "data.aws_cloudfront_vpc_origin.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCOriginDataSourceConfig_name(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCOriginExists(ctx, resourceName, &vpcorigin),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontVPCOriginDataSource_neitherIDNorName(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCOriginDataSourceConfig_neitherIDNorName(),
+				ExpectError: regexp.MustCompile(`Either "id" or "name" must be specified`),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontVPCOriginDataSource_nameMatchesMultiple(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCOriginDataSourceConfig_nameMatchesMultiple(sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)),
+				ExpectError: regexp.MustCompile(`(?i)too many results|matched multiple`),
+			},
+		},
+	})
+}
+
+func testAccVPCOriginDataSourceConfig_id(rName string) string {
+	return acctest.ConfigCompose(testAccVPCOriginConfig_basic(rName), `
+data "aws_cloudfront_vpc_origin" "test" {
+  id = aws_cloudfront_vpc_origin.test.id
+}
+`)
+}
+
+func testAccVPCOriginDataSourceConfig_name(rName string) string {
+	return acctest.ConfigCompose(testAccVPCOriginConfig_basic(rName), `
+data "aws_cloudfront_vpc_origin" "test" {
+  name = aws_cloudfront_vpc_origin.test.vpc_origin_endpoint_config.name
+}
+`)
+}
+
+func testAccVPCOriginDataSourceConfig_neitherIDNorName() string {
+	return `
+data "aws_cloudfront_vpc_origin" "test" {
+}
+`
+}
+
+// testAccVPCOriginDataSourceConfig_nameMatchesMultiple creates two VPC
+// Origins that share the same endpoint config name, so looking them up by
+// name hits findVPCOriginByName's "too many results" error path.
+func testAccVPCOriginDataSourceConfig_nameMatchesMultiple(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  count = 2
+
+  name               = "%[1]s-${count.index}"
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = aws_subnet.test[*].id
+}
+
+resource "aws_cloudfront_vpc_origin" "test" {
+  count = 2
+
+  vpc_origin_endpoint_config {
+    name                   = %[1]q
+    origin_arn             = aws_lb.test[count.index].arn
+    http_port              = 80
+    https_port             = 443
+    origin_protocol_policy = "http-only"
+    origin_ssl_protocols   = ["TLSv1.2"]
+  }
+}
+
+data "aws_cloudfront_vpc_origin" "test" {
+  name = %[1]q
+
+  depends_on = [aws_cloudfront_vpc_origin.test]
+}
+`, rName))
+}
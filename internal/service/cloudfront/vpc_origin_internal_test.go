@@ -0,0 +1,196 @@
+package cloudfront
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func newTestVPCOriginEndpointConfigModel(ctx context.Context, policy string, httpPort, httpsPort int32, sslProtocols ...string) *vpcOriginEndpointConfigModel {
+	return &vpcOriginEndpointConfigModel{
+		OriginProtocolPolicy: fwtypes.StringEnumValue(awstypes.OriginProtocolPolicy(policy)),
+		HTTPPort:             types.Int32Value(httpPort),
+		HTTPSPort:            types.Int32Value(httpsPort),
+		OriginSslProtocols:   fwtypes.NewSetValueOfMust[types.String](ctx, stringsToValues(sslProtocols)),
+	}
+}
+
+func TestDiffVPCOriginEndpointConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	planned := newTestVPCOriginEndpointConfigModel(ctx, "http-only", 80, 443, "TLSv1.2")
+
+	tests := map[string]struct {
+		remote *awstypes.VpcOriginEndpointConfig
+		want   []string
+	}{
+		"matches plan": {
+			remote: &awstypes.VpcOriginEndpointConfig{
+				OriginProtocolPolicy: awstypes.OriginProtocolPolicyHttpOnly,
+				HTTPPort:             aws.Int32(80),
+				HTTPSPort:            aws.Int32(443),
+				OriginSslProtocols:   &awstypes.OriginSslProtocols{Items: []string{"TLSv1.2"}, Quantity: aws.Int32(1)},
+			},
+			want: nil,
+		},
+		"protocol policy drifted": {
+			remote: &awstypes.VpcOriginEndpointConfig{
+				OriginProtocolPolicy: awstypes.OriginProtocolPolicyHttpsOnly,
+				HTTPPort:             aws.Int32(80),
+				HTTPSPort:            aws.Int32(443),
+				OriginSslProtocols:   &awstypes.OriginSslProtocols{Items: []string{"TLSv1.2"}, Quantity: aws.Int32(1)},
+			},
+			want: []string{"origin_protocol_policy"},
+		},
+		"ports and ssl protocols drifted": {
+			remote: &awstypes.VpcOriginEndpointConfig{
+				OriginProtocolPolicy: awstypes.OriginProtocolPolicyHttpOnly,
+				HTTPPort:             aws.Int32(8080),
+				HTTPSPort:            aws.Int32(8443),
+				OriginSslProtocols:   &awstypes.OriginSslProtocols{Items: []string{"TLSv1.1"}, Quantity: aws.Int32(1)},
+			},
+			want: []string{"http_port", "https_port", "origin_ssl_protocols"},
+		},
+		"nil remote": {
+			remote: nil,
+			want:   nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diffVPCOriginEndpointConfig(ctx, tt.remote, planned)
+			if len(got) != len(tt.want) {
+				t.Fatalf("diffVPCOriginEndpointConfig() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("diffVPCOriginEndpointConfig() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestVPCOriginEndpointConfigRequiresInvalidation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		old, new *vpcOriginEndpointConfigModel
+		want     bool
+	}{
+		"identical": {
+			old:  newTestVPCOriginEndpointConfigModel(ctx, "http-only", 80, 443, "TLSv1.2"),
+			new:  newTestVPCOriginEndpointConfigModel(ctx, "http-only", 80, 443, "TLSv1.2"),
+			want: false,
+		},
+		"ssl protocols reordered only": {
+			old:  newTestVPCOriginEndpointConfigModel(ctx, "http-only", 80, 443, "TLSv1.2", "TLSv1.1"),
+			new:  newTestVPCOriginEndpointConfigModel(ctx, "http-only", 80, 443, "TLSv1.1", "TLSv1.2"),
+			want: false,
+		},
+		"protocol policy changed": {
+			old:  newTestVPCOriginEndpointConfigModel(ctx, "http-only", 80, 443, "TLSv1.2"),
+			new:  newTestVPCOriginEndpointConfigModel(ctx, "https-only", 80, 443, "TLSv1.2"),
+			want: true,
+		},
+		"port changed": {
+			old:  newTestVPCOriginEndpointConfigModel(ctx, "http-only", 80, 443, "TLSv1.2"),
+			new:  newTestVPCOriginEndpointConfigModel(ctx, "http-only", 8080, 443, "TLSv1.2"),
+			want: true,
+		},
+		"ssl protocols changed": {
+			old:  newTestVPCOriginEndpointConfigModel(ctx, "http-only", 80, 443, "TLSv1.2"),
+			new:  newTestVPCOriginEndpointConfigModel(ctx, "http-only", 80, 443, "TLSv1.1"),
+			want: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := vpcOriginEndpointConfigRequiresInvalidation(ctx, tt.old, tt.new); got != tt.want {
+				t.Errorf("vpcOriginEndpointConfigRequiresInvalidation() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVPCOriginInvalidationCallerReference(t *testing.T) {
+	t.Parallel()
+
+	ref1 := vpcOriginInvalidationCallerReference("vo-1", "etag-1", "dist-1", []string{"/a", "/b"})
+	ref2 := vpcOriginInvalidationCallerReference("vo-1", "etag-1", "dist-1", []string{"/b", "/a"})
+	ref3 := vpcOriginInvalidationCallerReference("vo-1", "etag-2", "dist-1", []string{"/a", "/b"})
+
+	if ref1 != ref2 {
+		t.Errorf("expected caller reference to be independent of path order: %q != %q", ref1, ref2)
+	}
+	if ref1 == ref3 {
+		t.Errorf("expected caller reference to change when the ETag changes: %q == %q", ref1, ref3)
+	}
+}
+
+// testAttributeGetter is a minimal vpcOriginAttributeGetter fake so
+// vpcOriginETagRetryTimeout can be unit tested without a real tfsdk.Plan or
+// tfsdk.State.
+type testAttributeGetter struct {
+	value types.String
+}
+
+func (g testAttributeGetter) GetAttribute(_ context.Context, _ path.Path, target any) diag.Diagnostics {
+	*(target.(*types.String)) = g.value
+	return nil
+}
+
+func TestVPCOriginETagRetryTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("unset defaults", func(t *testing.T) {
+		t.Parallel()
+
+		got, diags := vpcOriginETagRetryTimeout(ctx, testAttributeGetter{value: types.StringNull()})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if got != defaultETagRetryTimeout {
+			t.Errorf("got %s, want default %s", got, defaultETagRetryTimeout)
+		}
+	})
+
+	t.Run("valid duration", func(t *testing.T) {
+		t.Parallel()
+
+		got, diags := vpcOriginETagRetryTimeout(ctx, testAttributeGetter{value: types.StringValue("10m")})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if got.String() != "10m0s" {
+			t.Errorf("got %s, want 10m0s", got)
+		}
+	})
+
+	t.Run("invalid duration surfaces a diagnostic instead of silently defaulting", func(t *testing.T) {
+		t.Parallel()
+
+		_, diags := vpcOriginETagRetryTimeout(ctx, testAttributeGetter{value: types.StringValue("5min")})
+		if !diags.HasError() {
+			t.Fatal("expected an error diagnostic for an invalid duration, got none")
+		}
+	})
+}
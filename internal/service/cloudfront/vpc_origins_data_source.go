@@ -0,0 +1,140 @@
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_cloudfront_vpc_origins", name="VPC Origins")
+func newCloudfrontVPCOriginsDataSource(_ context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &cloudfrontVPCOriginsDataSource{}, nil
+}
+
+type cloudfrontVPCOriginsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *cloudfrontVPCOriginsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_cloudfront_vpc_origins"
+}
+
+func (d *cloudfrontVPCOriginsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			names.AttrARN: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrNamePrefix: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Optional: true,
+			},
+			"ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			names.AttrARNs: schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *cloudfrontVPCOriginsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data vpcOriginsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().CloudFrontClient(ctx)
+
+	summaries, err := findVPCOrigins(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading CloudFront VPC Origins", err.Error())
+		return
+	}
+
+	var ids, arns []string
+	for _, summary := range summaries {
+		if !data.ARN.IsNull() && aws.ToString(summary.Arn) != data.ARN.ValueString() {
+			continue
+		}
+		if !data.NamePrefix.IsNull() && !hasPrefix(aws.ToString(summary.Name), data.NamePrefix.ValueString()) {
+			continue
+		}
+		if !data.Status.IsNull() && aws.ToString(summary.Status) != data.Status.ValueString() {
+			continue
+		}
+
+		ids = append(ids, aws.ToString(summary.Id))
+		arns = append(arns, aws.ToString(summary.Arn))
+	}
+
+	data.Id = types.StringValue(d.Meta().Region(ctx))
+	data.IDs = fwtypes.NewSetValueOfMust[types.String](ctx, stringsToValues(ids))
+	data.ARNs = fwtypes.NewSetValueOfMust[types.String](ctx, stringsToValues(arns))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findVPCOrigins(ctx context.Context, conn *cloudfront.Client) ([]awstypes.VpcOriginSummary, error) {
+	input := &cloudfront.ListVpcOriginsInput{}
+	var results []awstypes.VpcOriginSummary
+
+	for {
+		output, err := conn.ListVpcOrigins(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.VpcOriginList == nil {
+			break
+		}
+
+		results = append(results, output.VpcOriginList.Items...)
+
+		if output.VpcOriginList.NextMarker == nil {
+			break
+		}
+		input.Marker = output.VpcOriginList.NextMarker
+	}
+
+	return results, nil
+}
+
+func hasPrefix(s, prefix string) bool {
+	return len(s) >= len(prefix) && s[:len(prefix)] == prefix
+}
+
+func stringsToValues(in []string) []types.String {
+	out := make([]types.String, len(in))
+	for i, v := range in {
+		out[i] = types.StringValue(v)
+	}
+	return out
+}
+
+type vpcOriginsDataSourceModel struct {
+	ARN        types.String                     `tfsdk:"arn"`
+	ARNs       fwtypes.SetValueOf[types.String] `tfsdk:"arns"`
+	Id         types.String                     `tfsdk:"id"`
+	IDs        fwtypes.SetValueOf[types.String] `tfsdk:"ids"`
+	NamePrefix types.String                     `tfsdk:"name_prefix"`
+	Status     types.String                     `tfsdk:"status"`
+}
@@ -0,0 +1,107 @@
+package cloudfront
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontVPCOriginsDataSource_namePrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudfront_vpc_origins.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCOriginsDataSourceConfig_namePrefix(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, fmt.Sprintf("%s.#", names.AttrARNs), "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontVPCOriginsDataSource_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudfront_vpc_origins.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCOriginsDataSourceConfig_status(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontVPCOriginsDataSource_arn(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudfront_vpc_origin.test"
+	dataSourceName := "data.aws_cloudfront_vpc_origins.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCOriginDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCOriginsDataSourceConfig_arn(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCOriginsDataSourceConfig_namePrefix(rName string) string {
+	return acctest.ConfigCompose(testAccVPCOriginConfig_basic(rName), fmt.Sprintf(`
+data "aws_cloudfront_vpc_origins" "test" {
+  name_prefix = %[1]q
+
+  depends_on = [aws_cloudfront_vpc_origin.test]
+}
+`, rName))
+}
+
+func testAccVPCOriginsDataSourceConfig_status(rName string) string {
+	return acctest.ConfigCompose(testAccVPCOriginConfig_basic(rName), `
+data "aws_cloudfront_vpc_origins" "test" {
+  status = "Deployed"
+
+  depends_on = [aws_cloudfront_vpc_origin.test]
+}
+`)
+}
+
+func testAccVPCOriginsDataSourceConfig_arn(rName string) string {
+	return acctest.ConfigCompose(testAccVPCOriginConfig_basic(rName), `
+data "aws_cloudfront_vpc_origins" "test" {
+  arn = aws_cloudfront_vpc_origin.test.arn
+
+  depends_on = [aws_cloudfront_vpc_origin.test]
+}
+`)
+}